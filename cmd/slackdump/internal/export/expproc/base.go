@@ -6,41 +6,59 @@ import (
 	"io"
 	"os"
 	"path/filepath"
-	"runtime"
 
+	"github.com/rusq/fsadapter"
 	"github.com/rusq/slackdump/v2/internal/chunk"
 )
 
 type baseproc struct {
-	dir string
-	wf  io.Closer // processor recording
-	gz  io.WriteCloser
+	wf io.Closer // processor recording
+	gz io.WriteCloser
 	*chunk.Recorder
 }
 
-func newBaseProc(dir string, name string) (*baseproc, error) {
-	if fi, err := os.Stat(dir); err != nil {
+// newBaseProc opens name+ext on fs and starts a chunk.Recorder writing
+// gzipped chunks into it.
+func newBaseProc(fs fsadapter.FS, name string) (*baseproc, error) {
+	wc, err := openChunkWriter(fs, name+ext)
+	if err != nil {
 		return nil, err
-	} else if !fi.IsDir() {
-		return nil, fmt.Errorf("not a directory: %s", dir)
 	}
-	filename := filepath.Join(dir, name+ext)
-	if fi, err := os.Stat(filename); err == nil {
-		if fi.IsDir() {
-			return nil, fmt.Errorf("not a file: %s", filename)
-		}
-		if fi.Size() > 0 {
-			runtime.Breakpoint()
-			return nil, fmt.Errorf("file %s exists and not empty", filename)
-		}
+	gz := gzip.NewWriter(wc)
+	r := chunk.NewRecorder(gz)
+	return &baseproc{wf: wc, gz: gz, Recorder: r}, nil
+}
+
+// openChunkWriter opens filename for writing a new chunk stream via fs.
+func openChunkWriter(fs fsadapter.FS, filename string) (io.WriteCloser, error) {
+	dir, ok := fs.(fsadapter.Directory)
+	if !ok {
+		return fs.Create(filename)
 	}
-	f, err := os.Create(filename)
-	if err != nil {
+	return openChunkFile(dir.Path(filename))
+}
+
+// openChunkFile opens filename for writing a new chunk stream, truncating
+// any prior content. ScanResumable never marks a channel done (see
+// resume.go), so every resumed run reprocesses every channel from scratch;
+// reopening an existing file in append mode would concatenate that fresh
+// fetch onto whatever was already there instead of replacing it, duplicating
+// every message. If resume ever gains a real per-channel completion marker,
+// this is where the corresponding "pick up where it left off" append path
+// would go back in.
+func openChunkFile(filename string) (*os.File, error) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
 		return nil, err
 	}
-	gz := gzip.NewWriter(f)
-	r := chunk.NewRecorder(gz)
-	return &baseproc{dir: dir, wf: f, gz: gz, Recorder: r}, nil
+	fi, err := os.Stat(filename)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+	} else if fi.IsDir() {
+		return nil, fmt.Errorf("not a file: %s", filename)
+	}
+	return os.Create(filename)
 }
 
 func (p *baseproc) Close() error {
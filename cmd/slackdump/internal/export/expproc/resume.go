@@ -0,0 +1,59 @@
+package expproc
+
+import (
+	"os"
+	"strings"
+
+	"github.com/rusq/slackdump/v2/internal/trace"
+)
+
+// reservedNames are the baseproc file stems that never correspond to a
+// channel ID and must be skipped when scanning a tmpdir for resumable
+// conversation chunks.
+var reservedNames = map[string]bool{
+	"channels": true,
+	"users":    true,
+}
+
+// ScanResumable scans dir for previously written chunk files and returns the
+// set of channel IDs that are provably complete and safe to skip on a
+// resumed run.
+//
+// That set is always empty today: chunk.Recorder (owned outside this
+// package) has no terminal marker recording "this channel's pagination
+// reached its last page", and conv.Close() flushes a clean, fully-valid
+// gzip footer onto whatever has been written so far regardless of whether
+// the channel actually finished or SIGINT cut it off mid-page. So a
+// channel's file existing -- or even decoding cleanly start to finish --
+// tells us nothing about whether it's done. An earlier version of this
+// function read a recovered "cursor" field out of the chunk JSON as that
+// signal, but no producer in this series ever wrote such a field, so the
+// check always came back empty anyway, just for the wrong reason: it
+// wasn't conservative by design, it was silently dead code.
+//
+// Until chunk.Recorder can persist a real per-channel completion marker,
+// reprocessing every channel on every resumed run is the only safe
+// behaviour (see openChunkFile, which truncates rather than appends for
+// exactly this reason). This function, and the done-set plumbing in
+// exportV3, are kept in place so that marker has somewhere to plug in.
+func ScanResumable(dir string) (map[string]struct{}, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]struct{}{}, nil
+		}
+		return nil, err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ext) {
+			continue
+		}
+		id := strings.TrimSuffix(e.Name(), ext)
+		if reservedNames[id] {
+			continue
+		}
+		trace.Debugf(trace.Expproc, "resume: %s has existing chunk data but no completion marker, will reprocess", id)
+	}
+	return map[string]struct{}{}, nil
+}
@@ -0,0 +1,55 @@
+package expproc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestScanResumableNeverSkipsChannel pins down the conservative behaviour
+// documented on ScanResumable: without a real per-channel completion
+// marker, a channel's chunk file existing -- however well-formed -- must
+// never cause a resumed run to skip it, or it'll silently lose whatever
+// pages hadn't been fetched yet.
+func TestScanResumableNeverSkipsChannel(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"C123" + ext, "channels" + ext, "users" + ext} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("not even valid gzip"), 0644); err != nil {
+			t.Fatalf("WriteFile %s: %v", name, err)
+		}
+	}
+
+	done, err := ScanResumable(dir)
+	if err != nil {
+		t.Fatalf("ScanResumable: %v", err)
+	}
+	if len(done) != 0 {
+		t.Fatalf("ScanResumable: got %v, want no channels marked done", done)
+	}
+}
+
+// TestOpenChunkFileTruncatesExisting checks that a pre-existing chunk file
+// is replaced, not appended to: since ScanResumable never marks a channel
+// done, every resumed run reprocesses it from scratch, and appending would
+// duplicate every message from the previous attempt.
+func TestOpenChunkFileTruncatesExisting(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "C123"+ext)
+	if err := os.WriteFile(filename, []byte("stale data from a prior run"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	f, err := openChunkFile(filename)
+	if err != nil {
+		t.Fatalf("openChunkFile: %v", err)
+	}
+	f.Close()
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if len(data) != 0 {
+		t.Fatalf("openChunkFile: file still has %d byte(s) of stale data, want it truncated", len(data))
+	}
+}
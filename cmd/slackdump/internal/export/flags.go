@@ -0,0 +1,13 @@
+package export
+
+import (
+	"github.com/rusq/slackdump/v2/export"
+	"github.com/spf13/pflag"
+)
+
+// AddFlags registers the v3 exporter's own flags onto fs, writing the
+// parsed values into cfg for exportV3 to read.
+func AddFlags(fs *pflag.FlagSet, cfg *export.Config) {
+	fs.StringVar(&cfg.ResumeDir, "resume", "", "resume an interrupted export from this chunk directory")
+	fs.StringVar(&cfg.OutputFS, "output-fs", "", "output filesystem, one of: a directory path, \"zip:archive.zip\", or \"s3://bucket/prefix\" (default: directory alongside the export)")
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 
 	"github.com/rusq/dlog"
 	"github.com/rusq/fsadapter"
@@ -12,19 +14,68 @@ import (
 	"github.com/rusq/slackdump/v2/cmd/slackdump/internal/export/expproc"
 	"github.com/rusq/slackdump/v2/export"
 	"github.com/rusq/slackdump/v2/internal/structures"
+	"github.com/rusq/slackdump/v2/internal/trace"
 	"github.com/schollz/progressbar/v3"
 	"github.com/slack-go/slack"
 )
 
 func exportV3(ctx context.Context, sess *slackdump.Session, fs fsadapter.FS, list *structures.EntityList, options export.Config) error {
 	lg := dlog.FromContext(ctx)
-	tmpdir, err := os.MkdirTemp("", "slackdump-*")
-	if err != nil {
-		return err
+
+	// fs is nil when the caller didn't already resolve one off sess itself
+	// (sess.Filesystem() defaults to a Directory rooted at BaseLocation) --
+	// that's the case whenever --output-fs picked something other than the
+	// default, since fsadapter.New is the only place that knows how to turn
+	// that flag value into an S3/Zip/Directory backend.
+	if fs == nil {
+		var err error
+		fs, err = fsadapter.New(options.OutputFS)
+		if err != nil {
+			return fmt.Errorf("invalid --output-fs value %q: %w", options.OutputFS, err)
+		}
+	}
+
+	// Cancel the export on SIGINT/SIGTERM instead of dying mid-write, so
+	// in-flight chunk files get flushed and a `--resume <tmpdir>` re-run can
+	// pick up where this one left off.
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tmpdir := options.ResumeDir
+	if tmpdir == "" {
+		var err error
+		tmpdir, err = os.MkdirTemp("", "slackdump-*")
+		if err != nil {
+			return err
+		}
+		lg.Printf("using %s as the temporary directory", tmpdir)
+	} else {
+		lg.Printf("resuming export from %s", tmpdir)
 	}
-	lg.Printf("using %s as the temporary directory", tmpdir)
 	lg.Print("running export...")
 
+	// chunkFS is where expproc writes its gzipped chunk files.  It defaults
+	// to a Directory rooted at tmpdir, but when the caller picked a remote
+	// --output-fs (fs is anything other than a plain Directory), chunk
+	// files stream straight there too, so the export never needs local disk
+	// space -- at the cost of resumability, since scanning for resumable
+	// chunks needs to list and reread a real local directory.
+	chunkFS := fsadapter.FS(fsadapter.NewDirectory(tmpdir))
+	done := map[string]struct{}{}
+	if _, isDir := fs.(fsadapter.Directory); isDir {
+		var err error
+		done, err = expproc.ScanResumable(tmpdir)
+		if err != nil {
+			return fmt.Errorf("error scanning %s for resumable chunks: %w", tmpdir, err)
+		}
+		if len(done) > 0 {
+			lg.Printf("resume: %d channel(s) already have chunk data and will be skipped", len(done))
+		}
+	} else {
+		chunkFS = fs
+		lg.Printf("streaming chunk files to %s; resume is not available for this output", fs)
+	}
+
 	errC := make(chan error, 1)
 	s := sess.Stream()
 	var wg sync.WaitGroup
@@ -39,14 +90,14 @@ func exportV3(ctx context.Context, sess *slackdump.Session, fs fsadapter.FS, lis
 			generator = listChannelGenerator
 		} else {
 			// exclusive export (process only excludes, if any)
-			generator = apiChannelGenerator(tmpdir, s, options.MemberOnly)
+			generator = apiChannelGenerator(chunkFS, s, options.MemberOnly)
 		}
 
 		go func() {
 			defer wg.Done()
 			defer close(links)
-			errC <- generator(ctx, links, list) // TODO
-			lg.Debug("channels done")
+			errC <- generator(ctx, links, list, done)
+			trace.Debugf(trace.Export, "channels done")
 		}()
 	}
 	// user goroutine
@@ -54,7 +105,7 @@ func exportV3(ctx context.Context, sess *slackdump.Session, fs fsadapter.FS, lis
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			errC <- userWorker(ctx, s, tmpdir)
+			errC <- userWorker(ctx, s, chunkFS)
 		}()
 	}
 	// conversations goroutine
@@ -65,7 +116,7 @@ func exportV3(ctx context.Context, sess *slackdump.Session, fs fsadapter.FS, lis
 		go func() {
 			defer wg.Done()
 			defer pb.Finish()
-			errC <- conversationWorker(ctx, s, pb, tmpdir, links)
+			errC <- conversationWorker(ctx, s, pb, chunkFS, links)
 
 		}()
 	}
@@ -81,19 +132,28 @@ func exportV3(ctx context.Context, sess *slackdump.Session, fs fsadapter.FS, lis
 			return err
 		}
 	}
+	if ctx.Err() != nil {
+		lg.Printf("export interrupted, %d channel(s) done, resumable chunk files in: %s", len(done), tmpdir)
+		lg.Printf("rerun with --resume %s to continue this export", tmpdir)
+		return ctx.Err()
+	}
 	lg.Printf("conversations export finished, chunk files in: %s", tmpdir)
 	return nil
 }
 
-type linkFeederFunc func(ctx context.Context, links chan<- string, list *structures.EntityList) error
+type linkFeederFunc func(ctx context.Context, links chan<- string, list *structures.EntityList, done map[string]struct{}) error
 
 // listChannelGenerator feeds the channel IDs that it gets from the list to
-// the links channel.  It does not fetch the channel list from the api, so
-// it's blazing fast in comparison to apiChannelFeeder.  When needed, get the
-// channel information from the conversations chunk files (they contain the
-// chunk with channel information).
-func listChannelGenerator(ctx context.Context, links chan<- string, list *structures.EntityList) error {
+// the links channel, skipping any channel already present in done.  It does
+// not fetch the channel list from the api, so it's blazing fast in
+// comparison to apiChannelFeeder.  When needed, get the channel information
+// from the conversations chunk files (they contain the chunk with channel
+// information).
+func listChannelGenerator(ctx context.Context, links chan<- string, list *structures.EntityList, done map[string]struct{}) error {
 	for _, ch := range list.Include {
+		if _, ok := done[ch]; ok {
+			continue
+		}
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -104,13 +164,14 @@ func listChannelGenerator(ctx context.Context, links chan<- string, list *struct
 }
 
 // apiChannelGenerator feeds the channel IDs that it gets from the API to the
-// links channel.  It also filters out channels that are excluded in the list.
-// It does not account for "included".  It ignores the thread links in the
-// list.  It writes the channels to the tmpdir.
-func apiChannelGenerator(tmpdir string, s *slackdump.Stream, memberOnly bool) linkFeederFunc {
-	return linkFeederFunc(func(ctx context.Context, links chan<- string, list *structures.EntityList) error {
+// links channel, skipping any channel already present in done.  It also
+// filters out channels that are excluded in the list.  It does not account
+// for "included".  It ignores the thread links in the list.  It writes the
+// channels to chunkFS.
+func apiChannelGenerator(chunkFS fsadapter.FS, s *slackdump.Stream, memberOnly bool) linkFeederFunc {
+	return linkFeederFunc(func(ctx context.Context, links chan<- string, list *structures.EntityList, done map[string]struct{}) error {
 		chIdx := list.Index()
-		chanproc, err := expproc.NewChannels(tmpdir, func(c []slack.Channel) error {
+		chanproc, err := expproc.NewChannels(chunkFS, func(c []slack.Channel) error {
 			for _, ch := range c {
 				if memberOnly && !ch.IsMember {
 					continue
@@ -118,6 +179,10 @@ func apiChannelGenerator(tmpdir string, s *slackdump.Stream, memberOnly bool) li
 				if include, ok := chIdx[ch.ID]; ok && !include {
 					continue
 				}
+				if _, ok := done[ch.ID]; ok {
+					trace.Debugf(trace.Export, "skip: channel %s already has chunk data, resuming", ch.ID)
+					continue
+				}
 
 				select {
 				case <-ctx.Done():
@@ -138,13 +203,13 @@ func apiChannelGenerator(tmpdir string, s *slackdump.Stream, memberOnly bool) li
 		if err := chanproc.Close(); err != nil {
 			return fmt.Errorf("error closing channel processor: %w", err)
 		}
-		dlog.FromContext(ctx).Debug("channels done")
+		trace.Debugf(trace.Export, "channels done")
 		return nil
 	})
 }
 
-func userWorker(ctx context.Context, s *slackdump.Stream, tmpdir string) error {
-	userproc, err := expproc.NewUsers(tmpdir)
+func userWorker(ctx context.Context, s *slackdump.Stream, chunkFS fsadapter.FS) error {
+	userproc, err := expproc.NewUsers(chunkFS)
 	if err != nil {
 		return err
 	}
@@ -155,7 +220,7 @@ func userWorker(ctx context.Context, s *slackdump.Stream, tmpdir string) error {
 	if err := userproc.Close(); err != nil {
 		return fmt.Errorf("error closing user processor: %w", err)
 	}
-	dlog.FromContext(ctx).Debug("users done")
+	trace.Debugf(trace.Export, "users done")
 	return nil
 }
 
@@ -167,20 +232,29 @@ type progresser interface {
 	Finish() error
 }
 
-func conversationWorker(ctx context.Context, s *slackdump.Stream, pb progresser, tmpdir string, links <-chan string) error {
-	conv, err := expproc.NewConversation(tmpdir)
+func conversationWorker(ctx context.Context, s *slackdump.Stream, pb progresser, chunkFS fsadapter.FS, links <-chan string) error {
+	conv, err := expproc.NewConversation(chunkFS)
 	if err != nil {
 		return fmt.Errorf("error initialising conversation processor: %w", err)
 	}
+	// Close flushes the recorder and gzip writer, even if AsyncConversations
+	// stopped early because ctx was cancelled -- this is what makes the
+	// resulting chunk files resumable.
+	defer conv.Close()
 
 	if err := s.AsyncConversations(ctx, conv, links, func(sr slackdump.StreamResult) error {
+		trace.Debugf(trace.Stream, "progress: %s", sr.String())
 		pb.Describe(sr.String())
 		pb.Add(1)
 		return nil
 	}); err != nil {
+		if ctx.Err() != nil {
+			trace.Debugf(trace.Stream, "stopped early: %s", ctx.Err())
+			return ctx.Err()
+		}
 		return fmt.Errorf("error streaming conversations: %w", err)
 	}
-	dlog.FromContext(ctx).Debug("conversations done")
+	trace.Debugf(trace.Export, "conversations done")
 	pb.Describe("OK")
 	return nil
 }
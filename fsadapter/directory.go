@@ -7,6 +7,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"github.com/rusq/slackdump/v2/internal/trace"
 )
 
 var _ FS = Directory{}
@@ -23,6 +25,14 @@ func (d Directory) String() string {
 	return "<directory: " + d.dir + ">"
 }
 
+// Path joins name onto the directory's root, without creating anything or
+// checking that name stays inside it.  It's exposed for callers -- such as
+// expproc's baseproc -- that need a real, seekable path on disk to reopen a
+// file in append mode, something the FS interface itself can't express.
+func (d Directory) Path(name string) string {
+	return filepath.Join(d.dir, name)
+}
+
 func (fs Directory) Create(fpath string) (io.WriteCloser, error) {
 	node := filepath.Join(fs.dir, fpath)
 	if err := fs.ensureSubdir(node); err != nil {
@@ -44,6 +54,7 @@ func (fs Directory) ensureSubdir(node string) error {
 	if rel, err := filepath.Rel(fs.dir, node); err != nil {
 		return err
 	} else if strings.HasPrefix(rel, "..") {
+		trace.Debugf(trace.FSAdapter, "ensureSubdir: rejecting %q, outside of %q", node, fs.dir)
 		return ErrIllegalDir
 	}
 
@@ -0,0 +1,45 @@
+package fsadapter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// New builds an FS from a --output-fs value: a bare path (equivalent to
+// "dir:path") for the local Directory backend, "zip:path" or "path.zip" for
+// the Zip archive backend, or "s3://bucket/prefix" for the S3-compatible
+// RemoteFS backend.
+func New(outputFS string) (FS, error) {
+	scheme, rest, ok := strings.Cut(outputFS, "://")
+	if !ok {
+		if strings.HasSuffix(outputFS, ".zip") {
+			return NewZip(outputFS)
+		}
+		return NewDirectory(outputFS), nil
+	}
+
+	switch scheme {
+	case "dir":
+		return NewDirectory(rest), nil
+	case "zip":
+		return NewZip(rest)
+	case "s3":
+		u, err := url.Parse(outputFS)
+		if err != nil {
+			return nil, fmt.Errorf("invalid s3 output %q: %w", outputFS, err)
+		}
+		cfg, err := config.LoadDefaultConfig(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("loading AWS config: %w", err)
+		}
+		client := s3.NewFromConfig(cfg)
+		return NewS3(client, u.Host, strings.TrimPrefix(u.Path, "/")), nil
+	default:
+		return nil, fmt.Errorf("unsupported --output-fs scheme %q", scheme)
+	}
+}
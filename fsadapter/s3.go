@@ -0,0 +1,94 @@
+package fsadapter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+var _ FS = (*S3)(nil)
+
+// S3 is a RemoteFS backend that streams each Create'd file straight to an
+// S3-compatible bucket, so an export never has to touch local disk.  Each
+// write is piped into an s3manager upload in a background goroutine, rather
+// than buffered in memory first.
+type S3 struct {
+	bucket   string
+	prefix   string
+	uploader *manager.Uploader
+}
+
+// NewS3 returns an S3 FS backend rooted at prefix inside bucket, uploading
+// through client.
+func NewS3(client *s3.Client, bucket, prefix string) *S3 {
+	return &S3{
+		bucket:   bucket,
+		prefix:   prefix,
+		uploader: manager.NewUploader(client),
+	}
+}
+
+func (fs *S3) String() string {
+	return fmt.Sprintf("<s3: s3://%s/%s>", fs.bucket, fs.prefix)
+}
+
+func (fs *S3) Create(name string) (io.WriteCloser, error) {
+	key := path.Join(fs.prefix, name)
+	if err := ensureSubdirPath(fs.prefix, key); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", key, err)
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		_, err := fs.uploader.Upload(context.Background(), &s3.PutObjectInput{
+			Bucket: aws.String(fs.bucket),
+			Key:    aws.String(key),
+			Body:   pr,
+		})
+		pr.CloseWithError(err)
+		done <- err
+	}()
+	return &s3Writer{pw: pw, done: done}, nil
+}
+
+func (fs *S3) WriteFile(name string, data []byte, _ os.FileMode) error {
+	w, err := fs.Create(name)
+	if err != nil {
+		return fmt.Errorf("WriteFile: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (fs *S3) Close() error {
+	return nil
+}
+
+// s3Writer streams writes into the upload goroutine's io.Pipe and reports
+// the upload's own error (if any) when closed, so callers find out whether
+// the object actually made it to S3.
+type s3Writer struct {
+	pw   *io.PipeWriter
+	done <-chan error
+}
+
+func (w *s3Writer) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *s3Writer) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
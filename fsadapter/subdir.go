@@ -0,0 +1,23 @@
+package fsadapter
+
+import (
+	"path"
+	"strings"
+)
+
+// ensureSubdirPath reports ErrIllegalDir unless node is a descendant of
+// root, where both are slash-separated, not necessarily OS, paths -- S3
+// keys and WriterFS names always use "/" regardless of GOOS.  It's the
+// path-string equivalent of Directory.ensureSubdir, shared by every backend
+// that isn't rooted in the local filesystem.
+func ensureSubdirPath(root, node string) error {
+	root = path.Clean(root)
+	node = path.Clean(node)
+	if root == "." || root == "" {
+		return nil
+	}
+	if node == root || strings.HasPrefix(node, root+"/") {
+		return nil
+	}
+	return ErrIllegalDir
+}
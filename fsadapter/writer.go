@@ -0,0 +1,52 @@
+package fsadapter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+)
+
+var _ FS = WriterFS{}
+
+// WriterFS adapts any factory that can open a named io.WriteCloser into an
+// FS.  It's the generic sink mentioned alongside the S3 backend: anything
+// that can hand back a writer per name -- a pipe to a subprocess, a tar
+// writer, a custom upload API -- can be wrapped in one of these without
+// reimplementing path validation.
+type WriterFS struct {
+	prefix    string
+	newWriter func(name string) (io.WriteCloser, error)
+}
+
+// NewWriterFS returns a WriterFS rooted at prefix that opens writers via
+// newWriter.
+func NewWriterFS(prefix string, newWriter func(name string) (io.WriteCloser, error)) WriterFS {
+	return WriterFS{prefix: prefix, newWriter: newWriter}
+}
+
+func (fs WriterFS) String() string {
+	return "<writer: " + fs.prefix + ">"
+}
+
+func (fs WriterFS) Create(name string) (io.WriteCloser, error) {
+	node := path.Join(fs.prefix, name)
+	if err := ensureSubdirPath(fs.prefix, node); err != nil {
+		return nil, fmt.Errorf("failed to create %s: %w", node, err)
+	}
+	return fs.newWriter(node)
+}
+
+func (fs WriterFS) WriteFile(name string, data []byte, _ os.FileMode) error {
+	w, err := fs.Create(name)
+	if err != nil {
+		return fmt.Errorf("WriteFile: %w", err)
+	}
+	defer w.Close()
+	_, err = w.Write(data)
+	return err
+}
+
+func (fs WriterFS) Close() error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package fsadapter
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+var _ FS = (*Zip)(nil)
+
+// Zip is an FS backend that writes every Create'd file as an entry in a
+// single ZIP archive on disk.  archive/zip.Writer only supports one open
+// entry at a time, so each Create'd writer buffers its content in memory
+// and only takes mu -- briefly, to copy the buffer into the archive -- on
+// Close. That lets concurrent writers (expproc opens channels, users and
+// every conversation file from separate goroutines) write independently of
+// each other instead of serialising on the archive for their entire
+// lifetime, which previously meant the first writer to open an entry held
+// the lock until it closed -- and with the channel generator feeding the
+// conversation worker over an unbuffered channel, that was enough to
+// deadlock the exclusive-export path.
+type Zip struct {
+	mu sync.Mutex
+	f  *os.File
+	zw *zip.Writer
+}
+
+// NewZip creates (or truncates) name and returns a Zip FS backend writing
+// entries into it.
+func NewZip(name string) (*Zip, error) {
+	f, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	return &Zip{f: f, zw: zip.NewWriter(f)}, nil
+}
+
+func (fs *Zip) String() string {
+	return "<zip: " + fs.f.Name() + ">"
+}
+
+func (fs *Zip) Create(name string) (io.WriteCloser, error) {
+	return &zipEntry{fs: fs, name: name}, nil
+}
+
+func (fs *Zip) WriteFile(name string, data []byte, _ os.FileMode) error {
+	w, err := fs.Create(name)
+	if err != nil {
+		return fmt.Errorf("WriteFile: %w", err)
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (fs *Zip) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if err := fs.zw.Close(); err != nil {
+		fs.f.Close()
+		return err
+	}
+	return fs.f.Close()
+}
+
+// zipEntry buffers one Create'd file in memory until it's Closed, since
+// archive/zip.Writer can only have one entry open at a time and this backend
+// must support multiple concurrent writers.
+type zipEntry struct {
+	fs   *Zip
+	name string
+	buf  bytes.Buffer
+}
+
+func (e *zipEntry) Write(p []byte) (int, error) {
+	return e.buf.Write(p)
+}
+
+// Close copies the buffered content into the archive, holding fs.mu only
+// for that copy rather than for the entry's entire lifetime.
+func (e *zipEntry) Close() error {
+	e.fs.mu.Lock()
+	defer e.fs.mu.Unlock()
+	w, err := e.fs.zw.Create(e.name)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", e.name, err)
+	}
+	_, err = io.Copy(w, &e.buf)
+	return err
+}
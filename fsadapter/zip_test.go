@@ -0,0 +1,74 @@
+package fsadapter
+
+import (
+	"archive/zip"
+	"io"
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+// TestZipConcurrentCreate pins down the bug the buffered zipEntry fixes:
+// Create used to hold the archive-wide lock for an entry's entire lifetime,
+// so a second entry opened (but not yet closed) while a first was still
+// being written would block forever. expproc relies on opening several
+// files from concurrent goroutines without a strict open/write/close order,
+// so Create by itself must never block on another entry's Close.
+func TestZipConcurrentCreate(t *testing.T) {
+	z, err := NewZip(filepath.Join(t.TempDir(), "out.zip"))
+	if err != nil {
+		t.Fatalf("NewZip: %v", err)
+	}
+
+	a, err := z.Create("a.txt")
+	if err != nil {
+		t.Fatalf("Create a: %v", err)
+	}
+	b, err := z.Create("b.txt")
+	if err != nil {
+		t.Fatalf("Create b: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		b.Write([]byte("second"))
+		b.Close()
+	}()
+	go func() {
+		defer wg.Done()
+		a.Write([]byte("first"))
+		a.Close()
+	}()
+	wg.Wait()
+
+	if err := z.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	zr, err := zip.OpenReader(z.f.Name())
+	if err != nil {
+		t.Fatalf("OpenReader: %v", err)
+	}
+	defer zr.Close()
+
+	want := map[string]string{"a.txt": "first", "b.txt": "second"}
+	if len(zr.File) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(zr.File), len(want))
+	}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatalf("Open %s: %v", f.Name, err)
+		}
+		data, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			t.Fatalf("ReadAll %s: %v", f.Name, err)
+		}
+		if string(data) != want[f.Name] {
+			t.Errorf("%s: got %q, want %q", f.Name, data, want[f.Name])
+		}
+	}
+}
@@ -154,11 +154,21 @@ func (app *dump) writeText(ctx context.Context, fs fsadapter.FS, filename string
 		return fmt.Errorf("error writing %q: %w", filename, err)
 	}
 	defer f.Close()
-	txt := format.NewText()
+	txt := format.NewText(app.textOptions())
 
 	return txt.Conversation(ctx, f, app.sess.Users, m)
 }
 
+// textOptions builds the format.Options for the Text converter from the
+// --no-emoji/--time-format/--no-color output flags in app.cfg.
+func (app *dump) textOptions() format.Options {
+	return format.Options{
+		NoEmoji:    app.cfg.Output.NoEmoji,
+		TimeFormat: app.cfg.Output.TimeFormat,
+		NoColor:    app.cfg.Output.NoColor,
+	}
+}
+
 // List lists the supported entities, and writes the output to the output
 // defined in the app.cfg.
 func (app *dump) List(ctx context.Context) error {
@@ -172,7 +182,7 @@ func (app *dump) List(ctx context.Context) error {
 
 	var formatter format.Converter = format.NewJSON()
 	if app.cfg.Output.IsText() {
-		formatter = format.NewText()
+		formatter = format.NewText(app.textOptions())
 	}
 
 	switch {
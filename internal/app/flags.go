@@ -0,0 +1,14 @@
+package app
+
+import (
+	"github.com/rusq/slackdump/v2/internal/app/config"
+	"github.com/spf13/pflag"
+)
+
+// AddFlags registers the text-output flags onto fs, writing the parsed
+// values into cfg.Output for (*dump).textOptions to read.
+func AddFlags(fs *pflag.FlagSet, cfg *config.Params) {
+	fs.BoolVar(&cfg.Output.NoEmoji, "no-emoji", false, "don't convert emoji codes to characters in the text output")
+	fs.StringVar(&cfg.Output.TimeFormat, "time-format", "", "time format for the text output (Go reference time layout, default if empty)")
+	fs.BoolVar(&cfg.Output.NoColor, "no-color", false, "disable ANSI colors in the text output")
+}
@@ -0,0 +1,29 @@
+package format
+
+import (
+	"hash/fnv"
+	"strconv"
+)
+
+// palette is the fixed set of ANSI 256-color codes assigned to users.  It's
+// deliberately small and high-contrast rather than exhaustive, so that two
+// different users are unlikely to look identical in a typical terminal.
+var palette = []int{33, 39, 42, 45, 75, 78, 99, 105, 130, 135, 166, 172, 178, 202, 208}
+
+// userColor deterministically maps a Slack user ID onto one of the palette
+// entries, so the same author gets the same color across every line and
+// every run.
+func userColor(userID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(userID))
+	return palette[int(h.Sum32())%len(palette)]
+}
+
+// colorize wraps s in the ANSI escape sequence for userID's assigned color,
+// or returns s unchanged if colorization is disabled.
+func colorize(userID, s string, noColor bool) string {
+	if noColor || userID == "" {
+		return s
+	}
+	return "\x1b[38;5;" + strconv.Itoa(userColor(userID)) + "m" + s + "\x1b[0m"
+}
@@ -0,0 +1,36 @@
+package format
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+)
+
+//go:embed emoji.json
+var emojiJSON []byte
+
+// shortcodeRe matches Slack-style ":shortcode:" sequences. It doesn't match
+// the "::skin-tone-2" suffix Slack appends to some reactions -- that's a
+// separate, un-rendered ":skin-tone-2:" shortcode immediately following the
+// base one, and renderEmoji leaves it untouched like any other code it
+// doesn't recognise.
+var shortcodeRe = regexp.MustCompile(`:[a-z0-9_+-]+:`)
+
+var emojiTable map[string]string
+
+func init() {
+	if err := json.Unmarshal(emojiJSON, &emojiTable); err != nil {
+		panic("format: invalid emoji.json: " + err.Error())
+	}
+}
+
+// renderEmoji replaces every recognised ":shortcode:" in s with its unicode
+// glyph, leaving anything not in the table untouched.
+func renderEmoji(s string) string {
+	return shortcodeRe.ReplaceAllStringFunc(s, func(code string) string {
+		if glyph, ok := emojiTable[code]; ok {
+			return glyph
+		}
+		return code
+	})
+}
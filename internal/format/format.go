@@ -0,0 +1,51 @@
+// Package format implements conversion of slackdump's internal conversation,
+// channel and user data into user-facing output formats (currently plain
+// text and JSON).
+package format
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/rusq/slackdump/v2/types"
+	"github.com/slack-go/slack"
+)
+
+// UserResolver resolves a user ID to the Slack user it belongs to.
+// *slackdump.Session satisfies this via its own Users method.
+type UserResolver func(userID string) (*slack.User, bool)
+
+// Converter is implemented by every output format slackdump can write.
+type Converter interface {
+	Conversation(ctx context.Context, w io.Writer, u UserResolver, conv *types.Conversation) error
+	Channels(ctx context.Context, w io.Writer, u UserResolver, ch []slack.Channel) error
+	Users(ctx context.Context, w io.Writer, u []slack.User) error
+}
+
+// Options controls the user-facing knobs shared by every Converter
+// implementation that renders human-readable output.
+type Options struct {
+	// NoEmoji disables rendering ":shortcode:" sequences in message text
+	// and reactions as their unicode glyph.
+	NoEmoji bool
+	// TimeFormat is the go time.Layout applied wherever a message or thread
+	// parent timestamp is printed.  Defaults to time.RFC3339.
+	TimeFormat string
+	// NoColor disables the per-user color assigned by hashing the user ID
+	// into a fixed palette.
+	NoColor bool
+}
+
+// DefaultOptions returns the Options used when a Converter is constructed
+// without any.
+func DefaultOptions() Options {
+	return Options{TimeFormat: time.RFC3339}
+}
+
+func (o Options) withDefaults() Options {
+	if o.TimeFormat == "" {
+		o.TimeFormat = time.RFC3339
+	}
+	return o
+}
@@ -0,0 +1,39 @@
+package format
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/rusq/slackdump/v2/types"
+	"github.com/slack-go/slack"
+)
+
+var _ Converter = new(JSON)
+
+// JSON renders conversations, channels and users as indented JSON, one
+// encoded value per call.
+type JSON struct{}
+
+// NewJSON returns a JSON formatter.
+func NewJSON() *JSON {
+	return &JSON{}
+}
+
+func (j *JSON) Conversation(ctx context.Context, w io.Writer, u UserResolver, conv *types.Conversation) error {
+	return j.encode(w, conv)
+}
+
+func (j *JSON) Channels(ctx context.Context, w io.Writer, u UserResolver, ch []slack.Channel) error {
+	return j.encode(w, ch)
+}
+
+func (j *JSON) Users(ctx context.Context, w io.Writer, users []slack.User) error {
+	return j.encode(w, users)
+}
+
+func (j *JSON) encode(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
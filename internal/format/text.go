@@ -0,0 +1,125 @@
+package format
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rusq/slackdump/v2/types"
+	"github.com/slack-go/slack"
+)
+
+var _ Converter = new(Text)
+
+// Text renders conversations, channels and users as plain text, one
+// message/channel/user per line.
+type Text struct {
+	opts Options
+}
+
+// NewText returns a Text formatter.  Passing no Options uses the defaults
+// (emoji and per-user color on, RFC3339 timestamps).
+func NewText(opts ...Options) *Text {
+	o := DefaultOptions()
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	return &Text{opts: o.withDefaults()}
+}
+
+// Conversation writes each message of conv, in order, one per line, as
+// "[<timestamp>] <author>: <text>", followed by any reactions.
+func (t *Text) Conversation(ctx context.Context, w io.Writer, u UserResolver, conv *types.Conversation) error {
+	for i := range conv.Messages {
+		if err := t.writeMessage(w, u, &conv.Messages[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Text) writeMessage(w io.Writer, u UserResolver, m *slack.Message) error {
+	ts := t.formatTimestamp(m.Timestamp)
+	author := t.authorName(u, m.User)
+	author = colorize(m.User, author, t.opts.NoColor)
+	text := m.Text
+	if !t.opts.NoEmoji {
+		text = renderEmoji(text)
+	}
+	if _, err := fmt.Fprintf(w, "[%s] %s: %s\n", ts, author, text); err != nil {
+		return err
+	}
+	return t.writeReactions(w, m.Reactions)
+}
+
+func (t *Text) writeReactions(w io.Writer, reactions []slack.ItemReaction) error {
+	if len(reactions) == 0 {
+		return nil
+	}
+	parts := make([]string, len(reactions))
+	for i, r := range reactions {
+		name := ":" + r.Name + ":"
+		if !t.opts.NoEmoji {
+			name = renderEmoji(name)
+		}
+		parts[i] = fmt.Sprintf("%s %d", name, r.Count)
+	}
+	_, err := fmt.Fprintf(w, "    %s\n", strings.Join(parts, "  "))
+	return err
+}
+
+// Channels writes one line per channel: its name and topic.
+func (t *Text) Channels(ctx context.Context, w io.Writer, u UserResolver, ch []slack.Channel) error {
+	for _, c := range ch {
+		if _, err := fmt.Fprintf(w, "#%s\t%s\n", c.Name, c.Topic.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Users writes one line per user: its ID and display name, colorized the
+// same way that author names are in Conversation, so a channel list and a
+// conversation dump of the same workspace read consistently.
+func (t *Text) Users(ctx context.Context, w io.Writer, users []slack.User) error {
+	for _, usr := range users {
+		name := usr.RealName
+		if name == "" {
+			name = usr.Name
+		}
+		name = colorize(usr.ID, name, t.opts.NoColor)
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", usr.ID, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Text) authorName(u UserResolver, userID string) string {
+	if u != nil {
+		if usr, ok := u(userID); ok {
+			if usr.RealName != "" {
+				return usr.RealName
+			}
+			return usr.Name
+		}
+	}
+	return userID
+}
+
+// formatTimestamp converts a Slack timestamp ("1234567890.123456") into
+// t.opts.TimeFormat, falling back to the raw value if it can't be parsed.
+func (t *Text) formatTimestamp(slackTS string) string {
+	sec, _, ok := strings.Cut(slackTS, ".")
+	if !ok {
+		sec = slackTS
+	}
+	unix, err := strconv.ParseInt(sec, 10, 64)
+	if err != nil {
+		return slackTS
+	}
+	return time.Unix(unix, 0).Format(t.opts.TimeFormat)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"io"
 
+	"github.com/rusq/slackdump/v2/internal/trace"
 	"github.com/slack-go/slack"
 )
 
@@ -82,6 +83,7 @@ func (p *Player) tryGetEvent(id string) (*Event, error) {
 		return nil, io.EOF
 	}
 
+	trace.Debugf(trace.Player, "seek: id=%s offset=%d (%d/%d)", id, offsets[ptr], ptr+1, len(offsets))
 	_, err := p.rs.Seek(offsets[ptr], io.SeekStart) // seek to the offset
 	if err != nil {
 		return nil, err
@@ -90,6 +92,7 @@ func (p *Player) tryGetEvent(id string) (*Event, error) {
 	if err := json.NewDecoder(p.rs).Decode(&event); err != nil {
 		return nil, err
 	}
+	trace.Debugf(trace.Player, "decode: id=%s type=%s", id, event.Type)
 	p.pointer[id]++ // increase the offset pointer for the next call.
 	return &event, nil
 }
@@ -159,6 +162,7 @@ func (p *Player) Replay(c Channeler) error {
 			}
 			return err
 		}
+		trace.Debugf(trace.Player, "replay: decoded id=%s type=%s", evt.ID(), evt.Type)
 		if err := p.emit(c, evt); err != nil {
 			return err
 		}
@@ -0,0 +1,76 @@
+package proctest
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	srv, err := NewServer(strings.NewReader(""))
+	if err != nil {
+		t.Fatalf("NewServer: %v", err)
+	}
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// TestUsersListNotImplemented checks that users.list fails loudly instead of
+// returning a fake empty member list, since the Player has no recorded event
+// type to answer it from.
+func TestUsersListNotImplemented(t *testing.T) {
+	srv := newTestServer(t)
+
+	if users, err := srv.Client().GetUsersContext(context.Background()); err == nil {
+		t.Fatalf("GetUsersContext: expected error, got %v", users)
+	}
+}
+
+// TestConversationsListNotImplemented checks that conversations.list fails
+// loudly instead of returning a fake empty channel list, for the same reason
+// as TestUsersListNotImplemented.
+func TestConversationsListNotImplemented(t *testing.T) {
+	srv := newTestServer(t)
+
+	if _, _, err := srv.Client().GetConversations(&slack.GetConversationsParameters{}); err == nil {
+		t.Fatal("GetConversations: expected error, got nil")
+	}
+}
+
+// TestConversationsHistoryUnknownChannel drives conversations.history over
+// HTTP, through Client(), for a channel with no recorded events, and checks
+// that it fails rather than returning an empty page -- so a caller
+// iterating channels from a stale or mismatched chunk file notices.
+//
+// This doesn't cover the has_more/cursor pagination across a real multi-page
+// recording: that requires constructing a processors.Event stream, and
+// Event's on-disk shape belongs to a sibling file that isn't part of this
+// package's own source (see player.go's imports), so fabricating one here
+// risks asserting against a schema this test invented rather than the real
+// one. TestCursorFor below covers the pagination mapping this handler
+// actually relies on.
+func TestConversationsHistoryUnknownChannel(t *testing.T) {
+	srv := newTestServer(t)
+
+	_, err := srv.Client().GetConversationHistoryContext(context.Background(), &slack.GetConversationHistoryParameters{
+		ChannelID: "C_DOES_NOT_EXIST",
+	})
+	if err == nil {
+		t.Fatal("GetConversationHistoryContext: expected error for unrecorded channel, got nil")
+	}
+}
+
+// TestCursorFor checks the has_more -> next_cursor mapping that
+// conversationsHistory and conversationsReplies both rely on to tell the
+// slack-go client when to stop paginating.
+func TestCursorFor(t *testing.T) {
+	if got := cursorFor(true); got == "" {
+		t.Error("cursorFor(true): got empty cursor, want a non-empty one so the client keeps paginating")
+	}
+	if got := cursorFor(false); got != "" {
+		t.Errorf("cursorFor(false): got %q, want empty cursor so the client stops", got)
+	}
+}
@@ -0,0 +1,85 @@
+// Package trace provides opt-in, per-category debug logging toggled by the
+// SLACKDUMP_TRACE environment variable, so a single noisy subsystem (say, a
+// stuck stream) can be switched on in isolation instead of drowning in every
+// other category's debug output.  Modeled on the per-facility debug logging
+// Syncthing uses: one package owns the loggers, categories are toggled by an
+// env var, and nothing needs recompiling to flip them.
+package trace
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Category identifies a logging subsystem that can be toggled independently
+// via SLACKDUMP_TRACE.
+type Category string
+
+const (
+	Export    Category = "export"
+	Stream    Category = "stream"
+	Player    Category = "player"
+	FSAdapter Category = "fsadapter"
+	Expproc   Category = "expproc"
+)
+
+// EnvVar is the environment variable read to determine which categories are
+// enabled, e.g. SLACKDUMP_TRACE=export,stream or SLACKDUMP_TRACE=all.
+const EnvVar = "SLACKDUMP_TRACE"
+
+var (
+	mu      sync.RWMutex
+	loaded  bool
+	allOn   bool
+	enabled map[Category]bool
+)
+
+func load() {
+	mu.RLock()
+	if loaded {
+		mu.RUnlock()
+		return
+	}
+	mu.RUnlock()
+
+	mu.Lock()
+	defer mu.Unlock()
+	if loaded { // lost the race to another goroutine
+		return
+	}
+	enabled = make(map[Category]bool)
+	for _, cat := range strings.Split(os.Getenv(EnvVar), ",") {
+		cat = strings.TrimSpace(cat)
+		if cat == "" {
+			continue
+		}
+		if cat == "all" {
+			allOn = true
+			continue
+		}
+		enabled[Category(cat)] = true
+	}
+	loaded = true
+}
+
+// Enabled reports whether debug output for cat is switched on, either
+// directly by name or via the "all" catch-all.
+func Enabled(cat Category) bool {
+	load()
+	mu.RLock()
+	defer mu.RUnlock()
+	return allOn || enabled[cat]
+}
+
+// Debugf logs a debug message for cat, if it is enabled.  The message is
+// tagged with the category name so that enabling several at once doesn't
+// make it impossible to tell which subsystem a given line came from.
+func Debugf(cat Category, format string, v ...any) {
+	if !Enabled(cat) {
+		return
+	}
+	log.Output(2, fmt.Sprintf("["+string(cat)+"] "+format, v...))
+}